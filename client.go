@@ -0,0 +1,184 @@
+package launch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	Namespace                         = "launch"
+	LaunchedByMachineUserAnnotation   = "launch.astera.org/launched-by-machine-user"
+	LaunchedByTailscaleUserAnnotation = "launch.astera.org/launched-by-tailscale-user"
+	VersionAnnotation                 = "launch.astera.org/version"
+	GitCommitAnnotation               = "launch.astera.org/git-commit"
+	GitRemoteAnnotation               = "launch.astera.org/git-remote"
+
+	inClusterTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+// Client is a thin wrapper around a Kubernetes clientset scoped to a single
+// ClusterContext. It replaces the previous kubectl-shell-out implementation.
+type Client struct {
+	Clientset kubernetes.Interface
+	// Config is the rest.Config the Clientset was built from. It's exposed for callers that
+	// need to talk to the API server directly, such as the SPDY executor used by `launch exec`.
+	Config *rest.Config
+}
+
+// NewClient builds a Client for the given context. With "token" or "exec" auth,
+// the bearer token comes from the configured environment variable or command.
+// Otherwise, inside the cluster the pod's service account token is used, and
+// outside it the connection is authenticated by the caller's Tailscale network
+// identity (see the tailscale-operator config in `k8s-cluster.yml`), so the
+// bearer token is only needed to satisfy client-go's rest.Config validation.
+func NewClient(c ClusterContext) (*Client, error) {
+	token, err := bearerToken(c.config.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("resolving bearer token: %w", err)
+	}
+
+	cfg := &rest.Config{
+		Host:        c.ClusterURL(),
+		BearerToken: token,
+	}
+
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("building kubernetes client for context %q: %w", c, err)
+	}
+
+	return &Client{Clientset: clientset, Config: cfg}, nil
+}
+
+func bearerToken(auth AuthConfig) (string, error) {
+	switch auth.Type {
+	case AuthToken:
+		token := os.Getenv(auth.TokenEnvVar)
+		if token == "" {
+			return "", fmt.Errorf("environment variable %s (context auth.token_env_var) is empty", auth.TokenEnvVar)
+		}
+		return token, nil
+	case AuthExec:
+		if len(auth.ExecCommand) == 0 {
+			return "", fmt.Errorf("context auth.exec_command is empty")
+		}
+		output, err := exec.Command(auth.ExecCommand[0], auth.ExecCommand[1:]...).Output()
+		if err != nil {
+			return "", fmt.Errorf("running auth.exec_command: %w", err)
+		}
+		return strings.TrimSpace(string(output)), nil
+	}
+
+	if data, err := os.ReadFile(inClusterTokenPath); err == nil {
+		return strings.TrimSpace(string(data)), nil
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	// Not running in-cluster and no explicit auth configured: the API server trusts the
+	// Tailscale network identity of the connection, so any non-empty token works.
+	return "unused", nil
+}
+
+// Jobs lists the Jobs in the launch namespace.
+func (c *Client) Jobs(ctx context.Context, opts metav1.ListOptions) ([]batchv1.Job, error) {
+	var jobList *batchv1.JobList
+	err := retryTransient(ctx, func() error {
+		var err error
+		jobList, err = c.Clientset.BatchV1().Jobs(Namespace).List(ctx, opts)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing jobs: %w", err)
+	}
+	return jobList.Items, nil
+}
+
+// Pods lists the Pods in the launch namespace.
+func (c *Client) Pods(ctx context.Context, opts metav1.ListOptions) ([]corev1.Pod, error) {
+	var podList *corev1.PodList
+	err := retryTransient(ctx, func() error {
+		var err error
+		podList, err = c.Clientset.CoreV1().Pods(Namespace).List(ctx, opts)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pods: %w", err)
+	}
+	return podList.Items, nil
+}
+
+// AllPods lists Pods across every namespace, for scheduling calculations (e.g. GPU capacity
+// planning) that need to see load launch's namespace doesn't own.
+func (c *Client) AllPods(ctx context.Context, opts metav1.ListOptions) ([]corev1.Pod, error) {
+	var podList *corev1.PodList
+	err := retryTransient(ctx, func() error {
+		var err error
+		podList, err = c.Clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, opts)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pods across namespaces: %w", err)
+	}
+	return podList.Items, nil
+}
+
+// Nodes lists the cluster's nodes. Nodes are cluster-scoped, unlike Jobs and Pods.
+func (c *Client) Nodes(ctx context.Context, opts metav1.ListOptions) ([]corev1.Node, error) {
+	var nodeList *corev1.NodeList
+	err := retryTransient(ctx, func() error {
+		var err error
+		nodeList, err = c.Clientset.CoreV1().Nodes().List(ctx, opts)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing nodes: %w", err)
+	}
+	return nodeList.Items, nil
+}
+
+// retryTransient retries fn with backoff on errors that are likely to clear up on their own
+// (timeouts, throttling, apiserver restarts), leaving other errors to propagate immediately.
+func retryTransient(ctx context.Context, fn func() error) error {
+	backoff := wait.Backoff{Steps: 4, Duration: 200 * time.Millisecond, Factor: 2.0, Jitter: 0.1}
+	return retry.OnError(backoff, func(err error) bool {
+		return apierrors.IsServerTimeout(err) || apierrors.IsTooManyRequests(err) || apierrors.IsInternalError(err)
+	}, func() error {
+		return fn()
+	})
+}
+
+// SortJobsByCreation sorts jobs by creation timestamp, falling back to name to break ties.
+// Callers that want the previous `list jobs` ordering should call this explicitly.
+func SortJobsByCreation(jobs []batchv1.Job) {
+	sort.Slice(jobs, func(i, j int) bool {
+		if !jobs[i].CreationTimestamp.Equal(&jobs[j].CreationTimestamp) {
+			return jobs[i].CreationTimestamp.Before(&jobs[j].CreationTimestamp)
+		}
+		return jobs[i].Name < jobs[j].Name
+	})
+}
+
+// SortPodsByCreation sorts pods by creation timestamp, falling back to name to break ties.
+func SortPodsByCreation(pods []corev1.Pod) {
+	sort.Slice(pods, func(i, j int) bool {
+		if !pods[i].CreationTimestamp.Equal(&pods[j].CreationTimestamp) {
+			return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+		}
+		return pods[i].Name < pods[j].Name
+	})
+}
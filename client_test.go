@@ -0,0 +1,104 @@
+package launch
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestClientJobsPodsNodes(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "in-namespace", Namespace: Namespace}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-in-namespace", Namespace: Namespace}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-other-namespace", Namespace: "default"}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}},
+	)
+	client := &Client{Clientset: clientset}
+	ctx := context.Background()
+
+	jobs, err := client.Jobs(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Jobs: %v", err)
+	}
+	if len(jobs) != 1 || jobs[0].Name != "in-namespace" {
+		t.Errorf("Jobs() = %v, want a single job named in-namespace", jobs)
+	}
+
+	pods, err := client.Pods(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Pods: %v", err)
+	}
+	if len(pods) != 1 || pods[0].Name != "pod-in-namespace" {
+		t.Errorf("Pods() = %v, want only pods in the launch namespace", pods)
+	}
+
+	allPods, err := client.AllPods(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("AllPods: %v", err)
+	}
+	if len(allPods) != 2 {
+		t.Errorf("AllPods() returned %d pods, want 2 across all namespaces", len(allPods))
+	}
+
+	nodes, err := client.Nodes(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Nodes: %v", err)
+	}
+	if len(nodes) != 1 || nodes[0].Name != "node-a" {
+		t.Errorf("Nodes() = %v, want a single node named node-a", nodes)
+	}
+}
+
+// TestRetryTransientRetriesServerTimeout exercises retryTransient through a real List call: the
+// fake clientset is made to fail the first attempt with a server-timeout error (one of the
+// transient errors retryTransient is meant to absorb) before succeeding.
+func TestRetryTransientRetriesServerTimeout(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}})
+	attempts := 0
+	clientset.PrependReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		attempts++
+		if attempts == 1 {
+			return true, nil, apierrors.NewServerTimeout(schema.GroupResource{Resource: "nodes"}, "list", 1)
+		}
+		return false, nil, nil
+	})
+
+	client := &Client{Clientset: clientset}
+	nodes, err := client.Nodes(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Nodes: %v", err)
+	}
+	if attempts < 2 {
+		t.Errorf("attempts = %d, want retryTransient to retry after a server-timeout error", attempts)
+	}
+	if len(nodes) != 1 || nodes[0].Name != "node-a" {
+		t.Errorf("Nodes() = %v, want the single seeded node after retrying", nodes)
+	}
+}
+
+// TestRetryTransientDoesNotRetryOtherErrors guards against retryTransient swallowing retries it
+// shouldn't: a non-transient error (e.g. not-found) must propagate on the first attempt.
+func TestRetryTransientDoesNotRetryOtherErrors(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	attempts := 0
+	clientset.PrependReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		attempts++
+		return true, nil, apierrors.NewBadRequest("boom")
+	})
+
+	client := &Client{Clientset: clientset}
+	if _, err := client.Nodes(context.Background(), metav1.ListOptions{}); err == nil {
+		t.Fatal("Nodes() expected an error for a non-transient failure")
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want exactly 1 (no retry for a non-transient error)", attempts)
+	}
+}
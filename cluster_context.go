@@ -1,31 +1,70 @@
 package launch
 
-// ClusterContext represents different cluster environments
-type ClusterContext string
+// AuthMethod selects how NewClient obtains a bearer token for a context.
+type AuthMethod string
 
 const (
-	Berkeley    ClusterContext = "berkeley"
-	Staging     ClusterContext = "staging"
-	VoltagePark ClusterContext = "voltage-park"
+	// AuthTailscale relies on the caller's Tailscale network identity; the bearer token itself
+	// is ignored by the API server. This is the default when Auth is unset.
+	AuthTailscale AuthMethod = "tailscale"
+	// AuthToken reads a static bearer token from the environment variable named by
+	// AuthConfig.TokenEnvVar.
+	AuthToken AuthMethod = "token"
+	// AuthExec runs AuthConfig.ExecCommand and uses its trimmed stdout as the bearer token.
+	AuthExec AuthMethod = "exec"
 )
 
-// ClusterURL returns the cluster URL for the given context
+// AuthConfig describes how to authenticate to a context's API server.
+type AuthConfig struct {
+	Type        AuthMethod `mapstructure:"type" yaml:"type"`
+	TokenEnvVar string     `mapstructure:"token_env_var" yaml:"token_env_var,omitempty"`
+	ExecCommand []string   `mapstructure:"exec_command" yaml:"exec_command,omitempty"`
+}
+
+// ContextConfig is one entry of the `contexts:` list in the launch config file.
+type ContextConfig struct {
+	Name                string     `mapstructure:"name" yaml:"name"`
+	ClusterURL          string     `mapstructure:"cluster_url" yaml:"cluster_url"`
+	HeadlampURL         string     `mapstructure:"headlamp_url" yaml:"headlamp_url"`
+	DockerHost          string     `mapstructure:"docker_host" yaml:"docker_host"`
+	DockerHostInCluster string     `mapstructure:"docker_host_in_cluster" yaml:"docker_host_in_cluster"`
+	Default             bool       `mapstructure:"default" yaml:"default,omitempty"`
+	Auth                AuthConfig `mapstructure:"auth" yaml:"auth,omitempty"`
+}
+
+// ClusterContext represents one configured cluster environment. Unlike earlier versions of this
+// type, it's no longer a fixed set of hard-coded names: it's populated from the user's config
+// file (see Config/LoadConfig).
+type ClusterContext struct {
+	config ContextConfig
+}
+
+// Name returns the context's configured name (e.g. "berkeley").
+func (c ClusterContext) Name() string {
+	return c.config.Name
+}
+
+// ClusterURL returns the cluster's API server URL.
 func (c ClusterContext) ClusterURL() string {
-	return "https://" + string(c) + "-tailscale-operator.taila1eba.ts.net"
+	return c.config.ClusterURL
 }
 
-// HeadlampURL returns the headlamp URL for the given context
+// HeadlampURL returns the context's Headlamp URL.
 func (c ClusterContext) HeadlampURL() string {
-	return "https://" + string(c) + "-headlamp.taila1eba.ts.net"
+	return c.config.HeadlampURL
 }
 
-// DockerHost returns the docker host for the given context
+// DockerHost returns the docker host used to build and push images for this context.
 func (c ClusterContext) DockerHost() string {
-	return string(c) + "-docker.taila1eba.ts.net"
+	return c.config.DockerHost
+}
+
+// DockerHostInsideCluster returns the docker host as it should be referenced by pods running
+// inside the cluster (usually a containerd registry mirror; see `k8s-cluster.yml`).
+func (c ClusterContext) DockerHostInsideCluster() string {
+	return c.config.DockerHostInCluster
 }
 
-// DockerHostInsideCluster returns the docker host inside the cluster
-func (c *ClusterContext) DockerHostInsideCluster() string {
-	// Configured in `k8s-cluster.yml` under `containerd_registries_mirrors`.
-	return "astera-infra.com"
+func (c ClusterContext) String() string {
+	return c.config.Name
 }
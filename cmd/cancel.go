@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"astera-infra.com/launch"
+	"github.com/spf13/cobra"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	rootCmd.AddCommand(cancelCmd())
+}
+
+func cancelCmd() *cobra.Command {
+	var allMine bool
+
+	cmd := &cobra.Command{
+		Use:               "cancel [job]",
+		Short:             "Cancel a job",
+		ValidArgsFunction: jobNameCompletionFunc,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if allMine {
+				if len(args) != 0 {
+					return fmt.Errorf("--all-mine doesn't take a job name")
+				}
+				return nil
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			clusterContext, err := resolveContext()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			client, err := launch.NewClient(clusterContext)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			ctx := context.Background()
+			if allMine {
+				err = cancelAllMine(ctx, client)
+			} else {
+				err = cancelJob(ctx, client, args[0])
+			}
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&allMine, "all-mine", false, "Cancel every job launched by the current user")
+
+	return cmd
+}
+
+func cancelJob(ctx context.Context, client *launch.Client, name string) error {
+	propagation := metav1.DeletePropagationBackground
+	if err := client.Clientset.BatchV1().Jobs(launch.Namespace).Delete(ctx, name, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	}); err != nil {
+		return fmt.Errorf("cancelling job %s: %w", name, err)
+	}
+	fmt.Printf("Cancelled job %s\n", name)
+	return nil
+}
+
+func cancelAllMine(ctx context.Context, client *launch.Client) error {
+	identity := launch.LauncherAnnotations()
+	if len(identity) == 0 {
+		return fmt.Errorf("could not determine the current user; pass a job name instead of --all-mine")
+	}
+
+	jobs, err := client.Jobs(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, job := range jobs {
+		if !launchedBy(&job, identity) {
+			continue
+		}
+		if err := cancelJob(ctx, client, job.Name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to cancel %d job(s): %v", len(errs), errs)
+	}
+	return nil
+}
+
+func launchedBy(job *batchv1.Job, identity map[string]string) bool {
+	for key, value := range identity {
+		if job.Annotations[key] == value {
+			return true
+		}
+	}
+	return false
+}
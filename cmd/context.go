@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"astera-infra.com/launch"
+	"github.com/spf13/cobra"
+)
+
+var contextCmd = &cobra.Command{
+	Use:   "context",
+	Short: "Manage configured cluster contexts",
+}
+
+func init() {
+	rootCmd.AddCommand(contextCmd)
+	contextCmd.AddCommand(contextListCmd())
+	contextCmd.AddCommand(contextCurrentCmd())
+	contextCmd.AddCommand(contextUseCmd())
+	contextCmd.AddCommand(contextAddCmd())
+	contextCmd.AddCommand(contextRemoveCmd())
+}
+
+func contextListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List configured contexts",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, err := loadConfig()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			defaultName := cfg.DefaultContextName()
+			for _, ctx := range cfg.Contexts {
+				marker := "  "
+				if ctx.Name == defaultName {
+					marker = "* "
+				}
+				fmt.Printf("%s%s\t%s\n", marker, ctx.Name, ctx.ClusterURL)
+			}
+		},
+	}
+}
+
+func contextCurrentCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "current",
+		Short: "Print the context that would be used for other commands",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, err := resolveContext()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			fmt.Println(ctx.Name())
+		},
+	}
+}
+
+func contextUseCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <name>",
+		Short: "Set the default context",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, err := loadConfig()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			if err := cfg.UseContext(args[0]); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			if err := cfg.Save(); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			fmt.Printf("Default context is now %s\n", args[0])
+		},
+	}
+}
+
+func contextAddCmd() *cobra.Command {
+	var (
+		clusterURL          string
+		headlampURL         string
+		dockerHost          string
+		dockerHostInCluster string
+		auth                string
+		makeDefault         bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Add a new context",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, err := loadConfig()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			ctx := launch.ContextConfig{
+				Name:                args[0],
+				ClusterURL:          clusterURL,
+				HeadlampURL:         headlampURL,
+				DockerHost:          dockerHost,
+				DockerHostInCluster: dockerHostInCluster,
+				Default:             makeDefault,
+				Auth:                launch.AuthConfig{Type: launch.AuthMethod(auth)},
+			}
+
+			if err := cfg.AddContext(ctx); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			if makeDefault {
+				if err := cfg.UseContext(args[0]); err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+			}
+			if err := cfg.Save(); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			fmt.Printf("Added context %s\n", args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&clusterURL, "cluster-url", "", "Kubernetes API server URL")
+	cmd.Flags().StringVar(&headlampURL, "headlamp-url", "", "Headlamp URL")
+	cmd.Flags().StringVar(&dockerHost, "docker-host", "", "Docker host used to build and push images")
+	cmd.Flags().StringVar(&dockerHostInCluster, "docker-host-in-cluster", "", "Docker host as resolved from inside the cluster")
+	cmd.Flags().StringVar(&auth, "auth", string(launch.AuthTailscale), "Auth method: tailscale, token, or exec")
+	cmd.Flags().BoolVar(&makeDefault, "default", false, "Make this the default context")
+	cmd.MarkFlagRequired("cluster-url")
+
+	return cmd
+}
+
+func contextRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Remove a context",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, err := loadConfig()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			if err := cfg.RemoveContext(args[0]); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			if err := cfg.Save(); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			fmt.Printf("Removed context %s\n", args[0])
+		},
+	}
+}
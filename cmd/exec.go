@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"astera-infra.com/launch"
+	"github.com/spf13/cobra"
+
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func init() {
+	rootCmd.AddCommand(execCmd())
+}
+
+func execCmd() *cobra.Command {
+	var worker int
+
+	cmd := &cobra.Command{
+		Use:               "exec <job> -- <cmd>...",
+		Short:             "Run a command in a job's pod",
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: jobNameCompletionFunc,
+		Run: func(cmd *cobra.Command, args []string) {
+			dashIdx := cmd.ArgsLenAtDash()
+			if dashIdx <= 0 {
+				fmt.Println("expected `launch exec <job> -- <cmd>...`")
+				os.Exit(1)
+			}
+			job := args[0]
+			command := args[dashIdx:]
+			if len(command) == 0 {
+				fmt.Println("no command given after --")
+				os.Exit(1)
+			}
+
+			clusterContext, err := resolveContext()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			client, err := launch.NewClient(clusterContext)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			ctx := context.Background()
+			pods, err := podsForJob(ctx, client, job)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			pod, err := podForWorker(pods, worker)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			if err := execInPod(client, pod.Name, command); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().IntVar(&worker, "worker", -1, "Which worker index to exec into. Defaults to the job's only pod")
+
+	return cmd
+}
+
+func execInPod(client *launch.Client, podName string, command []string) error {
+	req := client.Clientset.CoreV1().RESTClient().
+		Post().
+		Resource("pods").
+		Namespace(launch.Namespace).
+		Name(podName).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Command: command,
+			Stdin:   true,
+			Stdout:  true,
+			Stderr:  true,
+			TTY:     true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(client.Config, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("creating executor: %w", err)
+	}
+
+	return executor.StreamWithContext(context.Background(), remotecommand.StreamOptions{
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+		Tty:    true,
+	})
+}
+
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"slices"
@@ -49,12 +50,16 @@ func listCmd() *cobra.Command {
 			if len(args) > 0 {
 				resource = args[0]
 			}
-			var err error
+			clusterContext, err := resolveContext()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
 			switch resource {
 			case "jobs":
-				err = listJobs(launch.ClusterContext(context))
+				err = listJobs(clusterContext)
 			case "nodes":
-				err = listNodes(launch.ClusterContext(context))
+				err = listNodes(clusterContext)
 			}
 			if err != nil {
 				fmt.Println(err)
@@ -84,16 +89,22 @@ func newTableWriter() table.Writer {
 	return tw
 }
 
-func listJobs(context launch.ClusterContext) error {
-	kubectl := launch.Kubectl{Server: context.ClusterURL()}
-	jobs, err := kubectl.Jobs()
+func listJobs(clusterContext launch.ClusterContext) error {
+	client, err := launch.NewClient(clusterContext)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	jobs, err := client.Jobs(ctx, metav1.ListOptions{})
 	if err != nil {
 		return err
 	}
-	pods, err := kubectl.Pods()
+	launch.SortJobsByCreation(jobs)
+	pods, err := client.Pods(ctx, metav1.ListOptions{})
 	if err != nil {
 		return err
 	}
+	launch.SortPodsByCreation(pods)
 	jobNameToPods := map[string][]*corev1.Pod{}
 	for _, pod := range pods {
 		// Note: empty job name is fine, we'll just ignore it later.
@@ -101,23 +112,43 @@ func listJobs(context launch.ClusterContext) error {
 		jobNameToPods[jobName] = append(jobNameToPods[jobName], &pod)
 	}
 	tw := newTableWriter()
-	tw.AppendHeader(table.Row{"name", fmt.Sprintf("created (%s)", utcOffset), "Job status", "launched by"})
+	tw.AppendHeader(table.Row{"name", fmt.Sprintf("created (%s)", utcOffset), "Job status", "completions", "launched by"})
 	for _, job := range jobs {
-		tw.AppendRow(table.Row{job.Name, formatTimestamp(job.CreationTimestamp), formatJobStatus(&job, jobNameToPods[job.Name]), determineUser(&job)})
+		tw.AppendRow(table.Row{job.Name, formatTimestamp(job.CreationTimestamp), formatJobStatus(&job, jobNameToPods[job.Name]), formatCompletions(&job), determineUser(&job)})
 	}
 	tw.Render()
 	return nil
 }
 
-func listNodes(context launch.ClusterContext) error {
-	kubectl := launch.Kubectl{Server: context.ClusterURL()}
-	nodes, err := kubectl.Nodes()
+// formatCompletions renders a (possibly Indexed) Job's succeeded pods against its desired
+// Completions, e.g. "2/4" succeeded out of 4 desired completions. Parallelism is not shown, and a
+// Job without Completions set renders as "".
+func formatCompletions(job *batchv1.Job) string {
+	if job.Spec.Completions == nil {
+		return ""
+	}
+	succeeded, _ := jobPodCounts(job)
+	return fmt.Sprintf("%d/%d", succeeded, *job.Spec.Completions)
+}
+
+func listNodes(clusterContext launch.ClusterContext) error {
+	client, err := launch.NewClient(clusterContext)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	nodes, err := client.Nodes(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	pods, err := client.AllPods(ctx, metav1.ListOptions{})
 	if err != nil {
 		return err
 	}
+	usage := launch.ComputeNodeGPUUsage(nodes, pods)
 
 	tw := newTableWriter()
-	tw.AppendHeader(table.Row{"node", "GPU", "GPU mem", "GPU count"})
+	tw.AppendHeader(table.Row{"node", "GPU", "GPU mem", "GPU count", "GPU free", "GPU used"})
 	for _, node := range nodes {
 		gpuProduct := node.Labels["nvidia.com/gpu.product"]
 		gpuMemory, err := formatGPUMemory(node.Labels["nvidia.com/gpu.memory"])
@@ -125,12 +156,15 @@ func listNodes(context launch.ClusterContext) error {
 			return err
 		}
 		gpuCount := node.Labels["nvidia.com/gpu.count"]
+		nodeUsage := usage[node.Name]
 
 		tw.AppendRow(table.Row{
 			node.Name,
 			gpuProduct,
 			gpuMemory,
 			gpuCount,
+			formatGPUHeadroom(nodeUsage.Free(), nodeUsage.Allocatable),
+			nodeUsage.Used,
 		})
 	}
 
@@ -138,6 +172,25 @@ func listNodes(context launch.ClusterContext) error {
 	return nil
 }
 
+// formatGPUHeadroom colorizes a node's free GPU count by how much headroom it leaves: none free
+// (including oversubscribed, i.e. negative) is red, some-but-not-all free is yellow, and fully
+// free is green. A GPU-less node renders as an uncolored "0".
+func formatGPUHeadroom(free, allocatable int64) string {
+	if allocatable == 0 {
+		return "0"
+	}
+	var color text.Color
+	switch {
+	case free <= 0:
+		color = text.FgRed
+	case free < allocatable:
+		color = text.FgYellow
+	default:
+		color = text.FgGreen
+	}
+	return fmt.Sprintf("%s%d%s", color.EscapeSeq(), free, text.Reset.EscapeSeq())
+}
+
 func formatGPUMemory(memoryStr string) (string, error) {
 	if memoryStr == "" {
 		return "", nil
@@ -190,9 +243,25 @@ func getLaunchedByTailscaleUser(meta metav1.ObjectMeta) string {
 	return ""
 }
 
+// jobPodCounts returns the number of succeeded/failed pods for job, including pods that have
+// terminated but are not yet reflected in job.Status.Succeeded/Failed because a pod-tracking
+// finalizer hasn't been removed yet. See job.Status.UncountedTerminatedPods.
+func jobPodCounts(job *batchv1.Job) (succeeded, failed int32) {
+	succeeded, failed = job.Status.Succeeded, job.Status.Failed
+	if uncounted := job.Status.UncountedTerminatedPods; uncounted != nil {
+		succeeded += int32(len(uncounted.Succeeded))
+		failed += int32(len(uncounted.Failed))
+	}
+	return succeeded, failed
+}
+
 func formatJobStatus(job *batchv1.Job, pods []*corev1.Pod) string {
 	var result strings.Builder
 
+	if succeeded, failed := jobPodCounts(job); succeeded > 0 || failed > 0 {
+		result.WriteString(fmt.Sprintf("succeeded: %d, failed: %d", succeeded, failed))
+	}
+
 	for _, condition := range job.Status.Conditions {
 		if condition.Status == corev1.ConditionTrue {
 			if result.Len() > 0 {
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"astera-infra.com/launch"
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func init() {
+	rootCmd.AddCommand(logsCmd())
+}
+
+func logsCmd() *cobra.Command {
+	var (
+		follow   bool
+		worker   int
+		previous bool
+	)
+
+	cmd := &cobra.Command{
+		Use:               "logs <job>",
+		Short:             "Print or stream a job's pod logs",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: jobNameCompletionFunc,
+		Run: func(cmd *cobra.Command, args []string) {
+			clusterContext, err := resolveContext()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			client, err := launch.NewClient(clusterContext)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			ctx := context.Background()
+			pods, err := podsForJob(ctx, client, args[0])
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			if len(pods) == 0 {
+				fmt.Printf("no pods found for job %s\n", args[0])
+				os.Exit(1)
+			}
+
+			if worker >= 0 {
+				pod, err := podForWorker(pods, worker)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+				pods = []corev1.Pod{*pod}
+			}
+
+			if err := streamLogs(ctx, client, pods, follow, previous); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Stream logs as they're written")
+	cmd.Flags().IntVar(&worker, "worker", -1, "Only show logs for this worker index. Defaults to all pods")
+	cmd.Flags().BoolVar(&previous, "previous", false, "Show logs from the previous terminated container instance")
+
+	return cmd
+}
+
+func streamLogs(ctx context.Context, client *launch.Client, pods []corev1.Pod, follow, previous bool) error {
+	prefixOutput := len(pods) > 1
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(pods))
+	for _, pod := range pods {
+		wg.Add(1)
+		go func(pod corev1.Pod) {
+			defer wg.Done()
+			opts := &corev1.PodLogOptions{Follow: follow, Previous: previous}
+			stream, err := client.Clientset.CoreV1().Pods(launch.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+			if err != nil {
+				errs <- fmt.Errorf("streaming logs for pod %s: %w", pod.Name, err)
+				return
+			}
+			defer stream.Close()
+
+			if !prefixOutput {
+				if _, err := io.Copy(os.Stdout, stream); err != nil {
+					errs <- fmt.Errorf("reading logs for pod %s: %w", pod.Name, err)
+				}
+				return
+			}
+
+			scanner := bufio.NewScanner(stream)
+			for scanner.Scan() {
+				fmt.Printf("[%s] %s\n", pod.Name, scanner.Text())
+			}
+			if err := scanner.Err(); err != nil {
+				errs <- fmt.Errorf("reading logs for pod %s: %w", pod.Name, err)
+			}
+		}(pod)
+	}
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"astera-infra.com/launch"
+	"github.com/spf13/cobra"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// podsForJob returns the pods belonging to job, in the same order `listJobs` displays them.
+func podsForJob(ctx context.Context, client *launch.Client, job string) ([]corev1.Pod, error) {
+	pods, err := client.Pods(ctx, metav1.ListOptions{LabelSelector: "job-name=" + job})
+	if err != nil {
+		return nil, fmt.Errorf("listing pods for job %s: %w", job, err)
+	}
+	launch.SortPodsByCreation(pods)
+	return pods, nil
+}
+
+// podForWorker resolves a single pod out of a job's pods by worker index, or returns the only
+// pod if there's exactly one and no index was requested. Worker index is matched against each
+// pod's Indexed Job completion-index annotation rather than its position in pods, since a
+// restarted pod's replacement sorts to the back of any creation-timestamp ordering but keeps the
+// same completion index.
+func podForWorker(pods []corev1.Pod, worker int) (*corev1.Pod, error) {
+	if worker < 0 {
+		if len(pods) != 1 {
+			return nil, fmt.Errorf("job has %d pods; pass --worker to select one", len(pods))
+		}
+		return &pods[0], nil
+	}
+	want := strconv.Itoa(worker)
+	for i := range pods {
+		if pods[i].Annotations[batchv1.JobCompletionIndexAnnotation] == want {
+			return &pods[i], nil
+		}
+	}
+	return nil, fmt.Errorf("worker %d out of range: job has %d pods", worker, len(pods))
+}
+
+// jobNameCompletionFunc lists jobs in the current context for shell completion of a job name
+// argument.
+func jobNameCompletionFunc(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	clusterContext, err := resolveContext()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	client, err := launch.NewClient(clusterContext)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	jobs, err := client.Jobs(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, len(jobs))
+	for i, job := range jobs {
+		names[i] = job.Name
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
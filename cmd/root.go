@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"os"
 
+	"astera-infra.com/launch"
 	"github.com/spf13/cobra"
 )
 
 var (
-	context string
-	rootCmd = &cobra.Command{
+	contextFlag string
+	configFlag  string
+	rootCmd     = &cobra.Command{
 		Use:     "launch",
 		Short:   "A tool to manage work on clusters",
 		Version: "0.1.0",
@@ -17,32 +19,68 @@ var (
 )
 
 func init() {
-	// Add global context flag
+	// Add global context and config flags
 	rootCmd.PersistentFlags().StringVar(
-		&context,
+		&contextFlag,
 		"context",
-		"berkeley",
-		"Context (AKA cluster) to use for the operation",
+		"",
+		"Context (AKA cluster) to use for the operation. Defaults to the context marked `default: true` in the config file",
+	)
+	rootCmd.PersistentFlags().StringVar(
+		&configFlag,
+		"config",
+		"",
+		"Path to the launch config file (default: $LAUNCH_CONFIG, or ~/.config/launch/config.yaml)",
 	)
 
-	// Register the valid contexts
-	validContexts := []string{"berkeley", "staging", "voltage-park"}
 	rootCmd.RegisterFlagCompletionFunc(
 		"context",
 		func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-			return validContexts, cobra.ShellCompDirectiveDefault
+			cfg, err := loadConfig()
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			return cfg.ContextNames(), cobra.ShellCompDirectiveDefault
 		},
 	)
 
-	// Add validation for context flag
+	// Add validation for the context flag. The `context` subcommand manages contexts and
+	// therefore validates its own arguments instead.
 	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
-		for _, validContext := range validContexts {
-			if context == validContext {
-				return nil
-			}
+		if cmd == contextCmd || cmd.Parent() == contextCmd {
+			return nil
 		}
-		return fmt.Errorf("invalid context: %s. Must be one of: %v", context, validContexts)
+		_, err := resolveContext()
+		return err
+	}
+}
+
+// loadConfig loads the launch config file, honoring --config/$LAUNCH_CONFIG.
+func loadConfig() (*launch.Config, error) {
+	path, err := launch.ConfigPath(configFlag)
+	if err != nil {
+		return nil, err
 	}
+	return launch.LoadConfig(path)
+}
+
+// resolveContext returns the ClusterContext named by --context, or the config's default context
+// if --context wasn't passed.
+func resolveContext() (launch.ClusterContext, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return launch.ClusterContext{}, err
+	}
+
+	name := contextFlag
+	if name == "" {
+		name = cfg.DefaultContextName()
+	}
+	if name == "" {
+		return launch.ClusterContext{}, fmt.Errorf("no context configured; run `launch context add` first")
+	}
+
+	return cfg.Context(name)
 }
 
 func Execute() {
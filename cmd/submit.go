@@ -1,23 +1,41 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
 
+	"astera-infra.com/launch"
 	"github.com/spf13/cobra"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const defaultNamePrefix = "job"
+const defaultRole = "worker"
+
 func init() {
 	rootCmd.AddCommand(submitCmd())
 }
 
 func submitCmd() *cobra.Command {
 	var (
-		builder       string
-		gpus          int
-		gpuMem        int
-		allowDirty    bool
-		allowUnpushed bool
-		namePrefix    string
+		builder        string
+		gpus           int
+		gpuMem         int
+		allowDirty     bool
+		allowUnpushed  bool
+		namePrefix     string
+		workers        int
+		workersPerRole map[string]int
+		roleImages     map[string]string
+		dryRun         bool
+		fit            bool
 	)
 
 	cmd := &cobra.Command{
@@ -25,7 +43,49 @@ func submitCmd() *cobra.Command {
 		Short: "Submit work to the cluster",
 		Args:  cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			fmt.Println("Submitting work...")
+			prefix := namePrefix
+			if prefix == "" {
+				prefix = defaultNamePrefix
+			}
+
+			clusterContext, err := resolveContext()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			opts := submitOptions{
+				command:        args,
+				builder:        builder,
+				gpus:           gpus,
+				gpuMem:         gpuMem,
+				allowDirty:     allowDirty,
+				allowUnpushed:  allowUnpushed,
+				namePrefix:     prefix,
+				workers:        workers,
+				workersPerRole: workersPerRole,
+				roleImages:     roleImages,
+			}
+
+			if dryRun {
+				if err := dryRunSubmit(context.Background(), clusterContext, opts, fit); err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+				return
+			}
+
+			result, err := submit(context.Background(), clusterContext, opts)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			headlampURL := clusterContext.HeadlampURL()
+			for _, job := range result.Jobs {
+				fmt.Printf("Submitted job %s\n", job.Name)
+				fmt.Printf("%s/c/main/jobs/%s/%s\n", headlampURL, launch.Namespace, job.Name)
+			}
 		},
 	}
 
@@ -35,6 +95,11 @@ func submitCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&allowDirty, "allow-dirty", false, "Allow dirty git state")
 	cmd.Flags().BoolVar(&allowUnpushed, "allow-unpushed", false, "Allow unpushed git changes")
 	cmd.Flags().StringVar(&namePrefix, "name-prefix", "", "Job name prefix of up to 20 characters, starting with an alphabetic character (a-z) and further consisting of alphanumeric characters (a-z, 0-9) optionally separated by dashes (-)")
+	cmd.Flags().IntVar(&workers, "workers", 0, "Number of replicas of the single default role. Ignored if --workers-per-role is set")
+	cmd.Flags().StringToIntVar(&workersPerRole, "workers-per-role", nil, "Number of replicas per role, e.g. --workers-per-role worker=4,ps=1. Implies a multi-role distributed job")
+	cmd.Flags().StringToStringVar(&roleImages, "role-image", nil, "Override the built image for a specific role, e.g. --role-image ps=my-registry/ps:latest")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print what would be submitted without building an image or creating anything")
+	cmd.Flags().BoolVar(&fit, "fit", false, "With --dry-run, print which nodes currently have enough free GPU capacity for this job")
 
 	validBuilders := []string{"docker", "kaniko"}
 	cmd.RegisterFlagCompletionFunc("builder", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -84,3 +149,286 @@ func isValidNamePrefix(prefix string) bool {
 	}
 	return true
 }
+
+type submitOptions struct {
+	command        []string
+	builder        string
+	gpus           int
+	gpuMem         int
+	allowDirty     bool
+	allowUnpushed  bool
+	namePrefix     string
+	workers        int
+	workersPerRole map[string]int
+	roleImages     map[string]string
+}
+
+// roleCounts returns the requested replica count per role, defaulting to a single role
+// ("worker") sized by --workers (or 1, if that's unset too).
+func (o submitOptions) roleCounts() map[string]int32 {
+	if len(o.workersPerRole) > 0 {
+		counts := make(map[string]int32, len(o.workersPerRole))
+		for role, n := range o.workersPerRole {
+			counts[role] = int32(n)
+		}
+		return counts
+	}
+	workers := o.workers
+	if workers <= 0 {
+		workers = 1
+	}
+	return map[string]int32{defaultRole: int32(workers)}
+}
+
+type submitResult struct {
+	Jobs    []*batchv1.Job
+	Service *corev1.Service
+}
+
+func submit(ctx context.Context, clusterContext launch.ClusterContext, opts submitOptions) (*submitResult, error) {
+	git, err := launch.ResolveGitState(opts.allowDirty, opts.allowUnpushed)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := launch.NewClient(clusterContext)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := launch.BuildAndPushImage(ctx, client, clusterContext, opts.builder, opts.namePrefix, git)
+	if err != nil {
+		return nil, fmt.Errorf("building image: %w", err)
+	}
+
+	groupName := fmt.Sprintf("%s-%s-%s", opts.namePrefix, shortSHA(git.SHA), randomSuffix(5))
+	spec := newJobSpec(groupName, opts, git, image)
+
+	if !spec.IsDistributed() {
+		job := singleRoleJob(spec)
+		created, err := client.Clientset.BatchV1().Jobs(launch.Namespace).Create(ctx, job, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("creating job: %w", err)
+		}
+		return &submitResult{Jobs: []*batchv1.Job{created}}, nil
+	}
+
+	jobs, service := launch.BuildDistributedResources(spec, launch.Namespace)
+
+	createdService, err := client.Clientset.CoreV1().Services(launch.Namespace).Create(ctx, service, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("creating headless service: %w", err)
+	}
+
+	createdJobs := make([]*batchv1.Job, 0, len(jobs))
+	for _, job := range jobs {
+		created, err := client.Clientset.BatchV1().Jobs(launch.Namespace).Create(ctx, job, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("creating job for role %s: %w", job.Labels[launch.JobRoleLabel], err)
+		}
+		createdJobs = append(createdJobs, created)
+	}
+
+	return &submitResult{Jobs: createdJobs, Service: createdService}, nil
+}
+
+func newJobSpec(groupName string, opts submitOptions, git *launch.GitState, image string) launch.JobSpec {
+	annotations := jobAnnotations(git)
+	resources := gpuResources(opts.gpus)
+	affinity := gpuMemoryAffinity(opts.gpuMem)
+
+	roles := map[string]launch.ReplicaSpec{}
+	for role, replicas := range opts.roleCounts() {
+		roleImage := image
+		if override, ok := opts.roleImages[role]; ok {
+			roleImage = override
+		}
+
+		roles[role] = launch.ReplicaSpec{
+			Replicas:      replicas,
+			RestartPolicy: corev1.RestartPolicyNever,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Affinity:      affinity,
+					Containers: []corev1.Container{
+						{
+							Name:      "main",
+							Image:     roleImage,
+							Args:      opts.command,
+							Resources: resources,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return launch.JobSpec{Name: groupName, Roles: roles}
+}
+
+// singleRoleJob renders a JobSpec with exactly one role and one replica as a plain (non-indexed)
+// Job, preserving the original submit behavior for the common single-worker case.
+func singleRoleJob(spec launch.JobSpec) *batchv1.Job {
+	var role string
+	var replica launch.ReplicaSpec
+	for r, rep := range spec.Roles {
+		role, replica = r, rep
+	}
+
+	backoffLimit := int32(0)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        spec.Name,
+			Namespace:   launch.Namespace,
+			Annotations: replica.Template.Annotations,
+			Labels:      map[string]string{launch.JobRoleLabel: role},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template:     replica.Template,
+		},
+	}
+}
+
+func jobAnnotations(git *launch.GitState) map[string]string {
+	annotations := map[string]string{
+		launch.GitCommitAnnotation: git.SHA,
+		launch.GitRemoteAnnotation: git.RemoteURL,
+		launch.VersionAnnotation:   rootCmd.Version,
+	}
+	for k, v := range launch.LauncherAnnotations() {
+		annotations[k] = v
+	}
+	return annotations
+}
+
+func gpuResources(gpus int) corev1.ResourceRequirements {
+	if gpus <= 0 {
+		return corev1.ResourceRequirements{}
+	}
+	quantity := resource.NewQuantity(int64(gpus), resource.DecimalSI)
+	return corev1.ResourceRequirements{Limits: corev1.ResourceList{"nvidia.com/gpu": *quantity}}
+}
+
+// gpuMemoryAffinity requires scheduling onto a node whose nvidia.com/gpu.memory label (in
+// mebibytes) is at least gpuMemGiB. A plain NodeSelector only matches labels by exact equality,
+// which would never match a node with more memory than requested, so this expresses ">=" via a
+// NodeAffinity Gt match instead, matching what fittingNodes predicts for --dry-run --fit.
+func gpuMemoryAffinity(gpuMemGiB int) *corev1.Affinity {
+	if gpuMemGiB <= 0 {
+		return nil
+	}
+	minMebibytes := gpuMemGiB*1024 - 1
+	return &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{
+								Key:      "nvidia.com/gpu.memory",
+								Operator: corev1.NodeSelectorOpGt,
+								Values:   []string{strconv.Itoa(minMebibytes)},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+const randomSuffixCharset = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+func randomSuffix(n int) string {
+	suffix := make([]byte, n)
+	for i := range suffix {
+		suffix[i] = randomSuffixCharset[rand.Intn(len(randomSuffixCharset))]
+	}
+	return string(suffix)
+}
+
+// dryRunSubmit prints what `submit` would do without building an image or creating anything.
+// With --fit, it also reports which nodes currently have enough free GPU capacity for the job.
+func dryRunSubmit(ctx context.Context, clusterContext launch.ClusterContext, opts submitOptions, fit bool) error {
+	fmt.Printf("Would submit to context %s:\n", clusterContext.Name())
+	for role, replicas := range opts.roleCounts() {
+		fmt.Printf("  role %s: %d replica(s)\n", role, replicas)
+	}
+	if opts.gpus > 0 {
+		fmt.Printf("  gpus per worker: %d\n", opts.gpus)
+	}
+	if opts.gpuMem > 0 {
+		fmt.Printf("  gpu mem per worker: %d GiB\n", opts.gpuMem)
+	}
+
+	if !fit {
+		return nil
+	}
+
+	client, err := launch.NewClient(clusterContext)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := client.Nodes(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	pods, err := client.AllPods(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	usage := launch.ComputeNodeGPUUsage(nodes, pods)
+
+	fitting, err := fittingNodes(nodes, usage, opts.gpus, opts.gpuMem)
+	if err != nil {
+		return err
+	}
+
+	if len(fitting) == 0 {
+		fmt.Println("WARNING: no nodes currently have enough free GPU capacity for this job; it would stay Pending indefinitely")
+		return nil
+	}
+
+	fmt.Println("Nodes that could fit this job:")
+	for _, name := range fitting {
+		fmt.Printf("  - %s\n", name)
+	}
+	return nil
+}
+
+// fittingNodes returns the names of nodes with enough free GPUs (per ComputeNodeGPUUsage) and
+// enough GPU memory (via the nvidia.com/gpu.memory label) to satisfy the requested gpus/gpuMemGiB.
+func fittingNodes(nodes []corev1.Node, usage map[string]launch.NodeGPUUsage, gpus, gpuMemGiB int) ([]string, error) {
+	var fitting []string
+	for _, node := range nodes {
+		if gpus > 0 && usage[node.Name].Free() < int64(gpus) {
+			continue
+		}
+		if gpuMemGiB > 0 {
+			memoryStr := node.Labels["nvidia.com/gpu.memory"]
+			if memoryStr == "" {
+				continue
+			}
+			mebibytes, err := strconv.ParseUint(memoryStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse GPU memory from %q: %w", memoryStr, err)
+			}
+			if mebibytes < uint64(gpuMemGiB)*1024 {
+				continue
+			}
+		}
+		fitting = append(fitting, node.Name)
+	}
+	return fitting, nil
+}
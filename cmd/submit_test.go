@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"testing"
+
+	"astera-infra.com/launch"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFittingNodes(t *testing.T) {
+	nodes := []corev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "plenty",
+				Labels: map[string]string{"nvidia.com/gpu.memory": "40960"}, // 40 GiB
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "full",
+				Labels: map[string]string{"nvidia.com/gpu.memory": "40960"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "low-mem",
+				Labels: map[string]string{"nvidia.com/gpu.memory": "16384"}, // 16 GiB
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-gpu-label"},
+		},
+	}
+	usage := map[string]launch.NodeGPUUsage{
+		"plenty":       {Allocatable: 8, Used: 2},
+		"full":         {Allocatable: 8, Used: 8},
+		"low-mem":      {Allocatable: 8, Used: 0},
+		"no-gpu-label": {Allocatable: 8, Used: 0},
+	}
+
+	fitting, err := fittingNodes(nodes, usage, 4, 24)
+	if err != nil {
+		t.Fatalf("fittingNodes: %v", err)
+	}
+	if len(fitting) != 1 || fitting[0] != "plenty" {
+		t.Errorf("fittingNodes() = %v, want [plenty]", fitting)
+	}
+}
+
+// TestGPUMemoryAffinityMatchesFittingNodes guards against the NodeSelector-vs-NodeAffinity bug
+// where --dry-run --fit predicted a node fit on "label >= requested" but the real submit path
+// only scheduled on "label == requested". A Gt match one below the requested value expresses the
+// same ">=" semantics fittingNodes uses.
+func TestGPUMemoryAffinityMatchesFittingNodes(t *testing.T) {
+	affinity := gpuMemoryAffinity(24)
+	if affinity == nil {
+		t.Fatal("gpuMemoryAffinity(24) = nil, want a NodeAffinity requirement")
+	}
+	terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) != 1 || len(terms[0].MatchExpressions) != 1 {
+		t.Fatalf("gpuMemoryAffinity(24) = %+v, want exactly one match expression", affinity)
+	}
+	expr := terms[0].MatchExpressions[0]
+	if expr.Key != "nvidia.com/gpu.memory" || expr.Operator != corev1.NodeSelectorOpGt {
+		t.Fatalf("match expression = %+v, want Gt on nvidia.com/gpu.memory", expr)
+	}
+	if want := []string{"24575"}; len(expr.Values) != 1 || expr.Values[0] != want[0] {
+		t.Errorf("Values = %v, want %v (one mebibyte below the 24 GiB requested)", expr.Values, want)
+	}
+}
+
+func TestFittingNodesInvalidGPUMemoryLabel(t *testing.T) {
+	nodes := []corev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "bad-label",
+				Labels: map[string]string{"nvidia.com/gpu.memory": "not-a-number"},
+			},
+		},
+	}
+	usage := map[string]launch.NodeGPUUsage{"bad-label": {Allocatable: 8}}
+
+	if _, err := fittingNodes(nodes, usage, 0, 24); err == nil {
+		t.Error("fittingNodes() expected an error for an unparseable gpu.memory label")
+	}
+}
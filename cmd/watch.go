@@ -0,0 +1,347 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"time"
+
+	"astera-infra.com/launch"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+const clearScreen = "\033[H\033[2J"
+
+func init() {
+	rootCmd.AddCommand(watchCmd())
+}
+
+func watchCmd() *cobra.Command {
+	var (
+		selector string
+		user     string
+		since    string
+		output   string
+	)
+
+	validArgs := []string{"jobs", "pods"}
+	cmd := &cobra.Command{
+		Use:       "watch [RESOURCE]",
+		Short:     "Watch jobs or pods for state changes",
+		ValidArgs: validArgs,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				return fmt.Errorf("expected at most 1 argument, got %d", len(args))
+			}
+			resource := validArgs[0]
+			if len(args) > 0 {
+				resource = args[0]
+			}
+			if !slices.Contains(validArgs, resource) {
+				return fmt.Errorf("invalid resource: %s. Must be one of: %v", resource, validArgs)
+			}
+			return nil
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			resource := validArgs[0]
+			if len(args) > 0 {
+				resource = args[0]
+			}
+
+			sinceTime, err := parseSince(since)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			opts := watchOptions{
+				resource: resource,
+				selector: selector,
+				user:     user,
+				since:    sinceTime,
+				json:     output == "json",
+			}
+
+			clusterContext, err := resolveContext()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			if err := watch(context.Background(), clusterContext, opts); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&selector, "selector", "", "Label selector to filter resources (e.g. 'launch.astera.org/job-role=worker')")
+	cmd.Flags().StringVar(&user, "user", "", "Only show resources launched by this user")
+	cmd.Flags().StringVar(&since, "since", "", "Only show resources created since this time (duration, e.g. '1h', or RFC3339 timestamp)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: (json)")
+
+	return cmd
+}
+
+func parseSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(since); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --since %q: must be a duration (e.g. '1h') or RFC3339 timestamp", since)
+	}
+	return t, nil
+}
+
+type watchOptions struct {
+	resource string
+	selector string
+	user     string
+	since    time.Time
+	json     bool
+}
+
+// watchEvent is the shape emitted by `-o json`, one line per change.
+type watchEvent struct {
+	Kind      string    `json:"kind"`            // "job" or "pod"
+	EventType string    `json:"eventType"`       // "Added", "Modified", "Deleted"
+	Name      string    `json:"name"`
+	Timestamp time.Time `json:"timestamp"`
+	Status    string    `json:"status,omitempty"`
+}
+
+func watch(ctx context.Context, clusterContext launch.ClusterContext, opts watchOptions) error {
+	client, err := launch.NewClient(clusterContext)
+	if err != nil {
+		return err
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(
+		client.Clientset,
+		30*time.Second,
+		informers.WithNamespace(launch.Namespace),
+		informers.WithTweakListOptions(func(listOpts *metav1.ListOptions) {
+			listOpts.LabelSelector = opts.selector
+		}),
+	)
+
+	state := &watchState{
+		jobs: map[string]*batchv1.Job{},
+		pods: map[string][]*corev1.Pod{},
+	}
+
+	render := func() {
+		if opts.json {
+			return
+		}
+		state.render(opts)
+	}
+
+	jobInformer := factory.Batch().V1().Jobs().Informer()
+	jobInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			job := obj.(*batchv1.Job)
+			if !matchesFilters(job.ObjectMeta, opts) {
+				return
+			}
+			state.setJob(job)
+			emitEvent(opts, "job", "Added", job.Name, formatJobStatus(job, state.pods[job.Name]))
+			render()
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			job := newObj.(*batchv1.Job)
+			if !matchesFilters(job.ObjectMeta, opts) {
+				return
+			}
+			state.setJob(job)
+			emitEvent(opts, "job", "Modified", job.Name, formatJobStatus(job, state.pods[job.Name]))
+			render()
+		},
+		DeleteFunc: func(obj interface{}) {
+			job, ok := obj.(*batchv1.Job)
+			if !ok {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					job, _ = tombstone.Obj.(*batchv1.Job)
+				}
+			}
+			if job == nil {
+				return
+			}
+			state.deleteJob(job.Name)
+			emitEvent(opts, "job", "Deleted", job.Name, "")
+			render()
+		},
+	})
+
+	podInformer := factory.Core().V1().Pods().Informer()
+	podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			pod := obj.(*corev1.Pod)
+			if !matchesFilters(pod.ObjectMeta, opts) {
+				return
+			}
+			state.setPod(pod)
+			emitEvent(opts, "pod", "Added", pod.Name, string(pod.Status.Phase))
+			render()
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			pod := newObj.(*corev1.Pod)
+			if !matchesFilters(pod.ObjectMeta, opts) {
+				return
+			}
+			state.setPod(pod)
+			emitEvent(opts, "pod", "Modified", pod.Name, string(pod.Status.Phase))
+			render()
+		},
+		DeleteFunc: func(obj interface{}) {
+			pod, ok := obj.(*corev1.Pod)
+			if !ok {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					pod, _ = tombstone.Obj.(*corev1.Pod)
+				}
+			}
+			if pod == nil {
+				return
+			}
+			state.deletePod(pod)
+			emitEvent(opts, "pod", "Deleted", pod.Name, "")
+			render()
+		},
+	})
+
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), jobInformer.HasSynced, podInformer.HasSynced) {
+		return fmt.Errorf("timed out waiting for informer cache to sync")
+	}
+
+	render()
+	<-ctx.Done()
+	return nil
+}
+
+func matchesFilters(meta metav1.ObjectMeta, opts watchOptions) bool {
+	if !opts.since.IsZero() && meta.CreationTimestamp.Time.Before(opts.since) {
+		return false
+	}
+	if opts.user != "" {
+		if getLaunchedByMachineUser(meta) != opts.user && getLaunchedByTailscaleUser(meta) != opts.user {
+			return false
+		}
+	}
+	return true
+}
+
+func emitEvent(opts watchOptions, kind, eventType, name, status string) {
+	if !opts.json {
+		return
+	}
+	event := watchEvent{
+		Kind:      kind,
+		EventType: eventType,
+		Name:      name,
+		Timestamp: time.Now(),
+		Status:    status,
+	}
+	encoded, err := json.Marshal(event)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(string(encoded))
+}
+
+// watchState tracks the latest known jobs/pods so the TUI can redraw the full table on each event.
+type watchState struct {
+	jobs map[string]*batchv1.Job
+	pods map[string][]*corev1.Pod // keyed by the `job-name` label
+}
+
+func (s *watchState) setJob(job *batchv1.Job) {
+	s.jobs[job.Name] = job
+}
+
+func (s *watchState) deleteJob(name string) {
+	delete(s.jobs, name)
+}
+
+func (s *watchState) setPod(pod *corev1.Pod) {
+	jobName := pod.Labels["job-name"]
+	pods := s.pods[jobName]
+	for i, existing := range pods {
+		if existing.Name == pod.Name {
+			pods[i] = pod
+			s.pods[jobName] = pods
+			return
+		}
+	}
+	s.pods[jobName] = append(pods, pod)
+}
+
+func (s *watchState) deletePod(pod *corev1.Pod) {
+	jobName := pod.Labels["job-name"]
+	pods := s.pods[jobName]
+	for i, existing := range pods {
+		if existing.Name == pod.Name {
+			s.pods[jobName] = slices.Delete(pods, i, i+1)
+			return
+		}
+	}
+}
+
+func (s *watchState) render(opts watchOptions) {
+	fmt.Print(clearScreen)
+
+	switch opts.resource {
+	case "jobs":
+		jobs := make([]*batchv1.Job, 0, len(s.jobs))
+		for _, job := range s.jobs {
+			jobs = append(jobs, job)
+		}
+		sort.Slice(jobs, func(i, j int) bool {
+			if !jobs[i].CreationTimestamp.Equal(&jobs[j].CreationTimestamp) {
+				return jobs[i].CreationTimestamp.Before(&jobs[j].CreationTimestamp)
+			}
+			return jobs[i].Name < jobs[j].Name
+		})
+
+		tw := newTableWriter()
+		tw.AppendHeader(table.Row{"name", fmt.Sprintf("created (%s)", utcOffset), "Job status", "launched by"})
+		for _, job := range jobs {
+			tw.AppendRow(table.Row{job.Name, formatTimestamp(job.CreationTimestamp), formatJobStatus(job, s.pods[job.Name]), determineUser(job)})
+		}
+		tw.Render()
+	case "pods":
+		var pods []*corev1.Pod
+		for _, jobPods := range s.pods {
+			pods = append(pods, jobPods...)
+		}
+		sort.Slice(pods, func(i, j int) bool {
+			if !pods[i].CreationTimestamp.Equal(&pods[j].CreationTimestamp) {
+				return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+			}
+			return pods[i].Name < pods[j].Name
+		})
+
+		tw := newTableWriter()
+		tw.AppendHeader(table.Row{"name", "job", fmt.Sprintf("created (%s)", utcOffset), "phase"})
+		for _, pod := range pods {
+			tw.AppendRow(table.Row{pod.Name, pod.Labels["job-name"], formatTimestamp(pod.CreationTimestamp), string(pod.Status.Phase)})
+		}
+		tw.Render()
+	}
+}
+
@@ -0,0 +1,166 @@
+package launch
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+)
+
+// legacyClusterDomain is the Tailscale domain the old hard-coded contexts used. It's only used
+// to seed a config file for users who don't have one yet.
+const legacyClusterDomain = "taila1eba.ts.net"
+
+// Config is the parsed contents of the launch config file (~/.config/launch/config.yaml by
+// default), plus the path it was loaded from so Save can write it back.
+type Config struct {
+	Contexts []ContextConfig `mapstructure:"contexts" yaml:"contexts"`
+
+	path string
+}
+
+// ConfigPath resolves the config file path, honoring --config, then LAUNCH_CONFIG, then the
+// default of ~/.config/launch/config.yaml.
+func ConfigPath(flagValue string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+	if env := os.Getenv("LAUNCH_CONFIG"); env != "" {
+		return env, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolving home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "launch", "config.yaml"), nil
+}
+
+// LoadConfig reads the config file at path. If it doesn't exist, a config seeded with launch's
+// historical hard-coded contexts is written to path and returned, so existing users keep working
+// without having to hand-author a config file first.
+func LoadConfig(path string) (*Config, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		cfg := &Config{Contexts: legacyContexts(), path: path}
+		if err := cfg.Save(); err != nil {
+			return nil, fmt.Errorf("writing default config to %s: %w", path, err)
+		}
+		return cfg, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("reading config %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("parsing config %s: %w", path, err)
+	}
+	cfg.path = path
+	return &cfg, nil
+}
+
+// Save writes the config back to the path it was loaded from (or seeded with).
+func (c *Config) Save() error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("creating config directory: %w", err)
+	}
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+	v.Set("contexts", c.Contexts)
+	if err := v.WriteConfigAs(c.path); err != nil {
+		return fmt.Errorf("writing config %s: %w", c.path, err)
+	}
+	return nil
+}
+
+// Context looks up a configured context by name.
+func (c *Config) Context(name string) (ClusterContext, error) {
+	for _, ctx := range c.Contexts {
+		if ctx.Name == name {
+			return ClusterContext{config: ctx}, nil
+		}
+	}
+	return ClusterContext{}, fmt.Errorf("unknown context: %s. Run `launch context list` to see configured contexts", name)
+}
+
+// DefaultContextName returns the name of the context marked `default: true`, falling back to the
+// first configured context, or "" if none are configured.
+func (c *Config) DefaultContextName() string {
+	for _, ctx := range c.Contexts {
+		if ctx.Default {
+			return ctx.Name
+		}
+	}
+	if len(c.Contexts) > 0 {
+		return c.Contexts[0].Name
+	}
+	return ""
+}
+
+// ContextNames returns the configured context names, in config file order.
+func (c *Config) ContextNames() []string {
+	names := make([]string, len(c.Contexts))
+	for i, ctx := range c.Contexts {
+		names[i] = ctx.Name
+	}
+	return names
+}
+
+// AddContext appends a new context, rejecting duplicate names.
+func (c *Config) AddContext(ctx ContextConfig) error {
+	for _, existing := range c.Contexts {
+		if existing.Name == ctx.Name {
+			return fmt.Errorf("context %q already exists", ctx.Name)
+		}
+	}
+	c.Contexts = append(c.Contexts, ctx)
+	return nil
+}
+
+// RemoveContext removes a context by name.
+func (c *Config) RemoveContext(name string) error {
+	for i, ctx := range c.Contexts {
+		if ctx.Name == name {
+			c.Contexts = append(c.Contexts[:i], c.Contexts[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown context: %s", name)
+}
+
+// UseContext marks name as the default context, clearing the flag on every other context.
+func (c *Config) UseContext(name string) error {
+	found := false
+	for i := range c.Contexts {
+		c.Contexts[i].Default = c.Contexts[i].Name == name
+		if c.Contexts[i].Default {
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("unknown context: %s", name)
+	}
+	return nil
+}
+
+func legacyContexts() []ContextConfig {
+	names := []string{"berkeley", "staging", "voltage-park"}
+	contexts := make([]ContextConfig, len(names))
+	for i, name := range names {
+		contexts[i] = ContextConfig{
+			Name:                name,
+			ClusterURL:          "https://" + name + "-tailscale-operator." + legacyClusterDomain,
+			HeadlampURL:         "https://" + name + "-headlamp." + legacyClusterDomain,
+			DockerHost:          name + "-docker." + legacyClusterDomain,
+			DockerHostInCluster: "astera-infra.com",
+			Default:             name == "berkeley",
+			Auth:                AuthConfig{Type: AuthTailscale},
+		}
+	}
+	return contexts
+}
@@ -0,0 +1,91 @@
+package launch
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// GitState captures the repository state that a submitted job is built from.
+type GitState struct {
+	SHA       string
+	RemoteURL string
+}
+
+// ResolveGitState inspects the current working tree and refuses to submit from a dirty or
+// unpushed tree unless explicitly overridden, matching the safety checks `submit` enforces
+// before building an image from HEAD.
+func ResolveGitState(allowDirty, allowUnpushed bool) (*GitState, error) {
+	dirty, err := gitIsDirty()
+	if err != nil {
+		return nil, fmt.Errorf("checking git status: %w", err)
+	}
+	if dirty && !allowDirty {
+		return nil, fmt.Errorf("working tree has uncommitted changes; commit or stash them, or pass --allow-dirty")
+	}
+
+	sha, err := gitHeadSHA()
+	if err != nil {
+		return nil, fmt.Errorf("resolving HEAD commit: %w", err)
+	}
+
+	pushed, err := gitIsPushed(sha)
+	if err != nil {
+		return nil, fmt.Errorf("checking whether HEAD is pushed: %w", err)
+	}
+	if !pushed && !allowUnpushed {
+		return nil, fmt.Errorf("HEAD (%s) is not pushed to a remote; push it or pass --allow-unpushed", sha)
+	}
+
+	remoteURL, err := gitRemoteURL()
+	if err != nil {
+		return nil, fmt.Errorf("resolving git remote: %w", err)
+	}
+
+	return &GitState{SHA: sha, RemoteURL: remoteURL}, nil
+}
+
+func gitIsDirty() (bool, error) {
+	output, err := runGit("status", "--porcelain")
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(output) != "", nil
+}
+
+func gitHeadSHA() (string, error) {
+	output, err := runGit("rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// gitIsPushed reports whether sha is reachable from any remote-tracking branch.
+func gitIsPushed(sha string) (bool, error) {
+	output, err := runGit("branch", "-r", "--contains", sha)
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(output) != "", nil
+}
+
+func gitRemoteURL() (string, error) {
+	output, err := runGit("remote", "get-url", "origin")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(output), nil
+}
+
+func runGit(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, stderr.String())
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,50 @@
+package launch
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GPUResourceName is the extended resource nvidia's device plugin advertises on GPU nodes.
+const GPUResourceName = corev1.ResourceName("nvidia.com/gpu")
+
+// NodeGPUUsage is a node's GPU capacity and how much of it is currently spoken for.
+type NodeGPUUsage struct {
+	Allocatable int64
+	Used        int64
+}
+
+// Free returns the number of GPUs not currently requested by a non-terminal pod.
+func (u NodeGPUUsage) Free() int64 {
+	return u.Allocatable - u.Used
+}
+
+// ComputeNodeGPUUsage cross-references pods against nodes to compute free GPU capacity per node:
+// nodes report total allocatable GPUs, but not how many are already claimed by running pods.
+func ComputeNodeGPUUsage(nodes []corev1.Node, pods []corev1.Pod) map[string]NodeGPUUsage {
+	usage := make(map[string]NodeGPUUsage, len(nodes))
+	for _, node := range nodes {
+		allocatable := node.Status.Allocatable[GPUResourceName]
+		usage[node.Name] = NodeGPUUsage{Allocatable: allocatable.Value()}
+	}
+
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		u, ok := usage[pod.Spec.NodeName]
+		if !ok {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			if quantity, ok := container.Resources.Requests[GPUResourceName]; ok {
+				u.Used += quantity.Value()
+			}
+		}
+		usage[pod.Spec.NodeName] = u
+	}
+
+	return usage
+}
@@ -0,0 +1,67 @@
+package launch
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func gpuNode(name string, allocatable int64) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				GPUResourceName: *resource.NewQuantity(allocatable, resource.DecimalSI),
+			},
+		},
+	}
+}
+
+func gpuPod(node string, phase corev1.PodPhase, gpus int64) corev1.Pod {
+	pod := corev1.Pod{
+		Spec: corev1.PodSpec{
+			NodeName: node,
+			Containers: []corev1.Container{
+				{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							GPUResourceName: *resource.NewQuantity(gpus, resource.DecimalSI),
+						},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+	return pod
+}
+
+func TestComputeNodeGPUUsage(t *testing.T) {
+	nodes := []corev1.Node{gpuNode("a", 8), gpuNode("b", 4)}
+	pods := []corev1.Pod{
+		gpuPod("a", corev1.PodRunning, 2),
+		gpuPod("a", corev1.PodRunning, 1),
+		gpuPod("a", corev1.PodSucceeded, 4), // terminal: shouldn't count
+		gpuPod("b", corev1.PodFailed, 4),    // terminal: shouldn't count
+		gpuPod("unknown-node", corev1.PodRunning, 1),
+		{}, // unscheduled pod (no NodeName): shouldn't count
+	}
+
+	usage := ComputeNodeGPUUsage(nodes, pods)
+
+	if got := usage["a"]; got.Allocatable != 8 || got.Used != 3 || got.Free() != 5 {
+		t.Errorf("node a: got %+v, want Allocatable=8 Used=3 Free=5", got)
+	}
+	if got := usage["b"]; got.Allocatable != 4 || got.Used != 0 || got.Free() != 4 {
+		t.Errorf("node b: got %+v, want Allocatable=4 Used=0 Free=4", got)
+	}
+}
+
+func TestNodeGPUUsageFreeCanGoNegative(t *testing.T) {
+	usage := NodeGPUUsage{Allocatable: 4, Used: 6}
+	if got := usage.Free(); got != -2 {
+		t.Errorf("Free() = %d, want -2", got)
+	}
+}
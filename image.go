@@ -0,0 +1,132 @@
+package launch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const kanikoImage = "gcr.io/kaniko-project/executor:latest"
+
+// BuildAndPushImage builds the image for the given git state using builder ("docker" or
+// "kaniko"), pushes it to the context's docker host, and returns the reference rewritten to
+// point at the in-cluster registry mirror so it can be pulled by the submitted Job.
+func BuildAndPushImage(ctx context.Context, client *Client, clusterContext ClusterContext, builder, namePrefix string, git *GitState) (string, error) {
+	ref := fmt.Sprintf("%s/%s:%s", clusterContext.DockerHost(), namePrefix, git.SHA)
+
+	var err error
+	switch builder {
+	case "docker":
+		err = buildAndPushWithDocker(ref)
+	case "kaniko":
+		err = buildAndPushWithKaniko(ctx, client, ref, git)
+	default:
+		return "", fmt.Errorf("unknown builder: %s", builder)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	inClusterRef := rewriteForInCluster(ref, clusterContext)
+	return inClusterRef, nil
+}
+
+func rewriteForInCluster(ref string, clusterContext ClusterContext) string {
+	return strings.Replace(ref, clusterContext.DockerHost(), clusterContext.DockerHostInsideCluster(), 1)
+}
+
+// gitContextHost rewrites a git remote URL into the bare "host/path" form kaniko's `git://`
+// build context expects. SSH shorthand remotes (git@github.com:org/repo.git, the default for
+// most engineers) separate host from path with a colon rather than a slash; left as-is, that
+// colon gets parsed as a bogus port instead of a path segment.
+func gitContextHost(remoteURL string) string {
+	if rest := strings.TrimPrefix(remoteURL, "git@"); rest != remoteURL {
+		if i := strings.IndexByte(rest, ':'); i >= 0 {
+			return rest[:i] + "/" + rest[i+1:]
+		}
+		return rest
+	}
+	return strings.TrimPrefix(remoteURL, "https://")
+}
+
+func buildAndPushWithDocker(ref string) error {
+	build := exec.Command("docker", "build", "-t", ref, ".")
+	build.Stdout = os.Stdout
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		return fmt.Errorf("docker build: %w", err)
+	}
+
+	push := exec.Command("docker", "push", ref)
+	push.Stdout = os.Stdout
+	push.Stderr = os.Stderr
+	if err := push.Run(); err != nil {
+		return fmt.Errorf("docker push: %w", err)
+	}
+
+	return nil
+}
+
+// buildAndPushWithKaniko runs an in-cluster kaniko pod that builds directly from the pushed git
+// commit (kaniko supports `git://` build contexts), so we never have to tar up and upload the
+// local working tree.
+func buildAndPushWithKaniko(ctx context.Context, client *Client, ref string, git *GitState) error {
+	gitContext := fmt.Sprintf("git://%s#%s", gitContextHost(git.RemoteURL), git.SHA)
+
+	podName := fmt.Sprintf("kaniko-build-%d", time.Now().Unix())
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podName,
+			Namespace: Namespace,
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{
+				{
+					Name:  "kaniko",
+					Image: kanikoImage,
+					Args: []string{
+						"--context=" + gitContext,
+						"--destination=" + ref,
+					},
+				},
+			},
+		},
+	}
+
+	created, err := client.Clientset.CoreV1().Pods(Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("creating kaniko build pod: %w", err)
+	}
+	defer client.Clientset.CoreV1().Pods(Namespace).Delete(context.Background(), created.Name, metav1.DeleteOptions{})
+
+	return waitForPodCompletion(ctx, client, created.Name)
+}
+
+func waitForPodCompletion(ctx context.Context, client *Client, podName string) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+
+		pod, err := client.Clientset.CoreV1().Pods(Namespace).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("getting kaniko build pod: %w", err)
+		}
+
+		switch pod.Status.Phase {
+		case corev1.PodSucceeded:
+			return nil
+		case corev1.PodFailed:
+			return fmt.Errorf("kaniko build pod %s failed", podName)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package launch
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// JobGroupLabel groups the per-role Jobs and the headless Service of a single distributed
+	// submission together.
+	JobGroupLabel = "launch.astera.org/job-group"
+	JobRoleLabel  = "launch.astera.org/job-role"
+
+	// RoleEnvVar identifies which role a pod belongs to. RankEnvVar is the pod's true global
+	// rank (0..WorldSize-1, with the master role's index-0 pod always at rank 0), computed from
+	// this role's static rank offset plus RoleIndexEnvVar, the pod's index within its own role
+	// (populated via the Indexed Job completion index). Standard launchers (torchrun etc.) can
+	// read RankEnvVar directly with no further math.
+	RoleEnvVar       = "LAUNCH_ROLE"
+	RoleIndexEnvVar  = "LAUNCH_ROLE_INDEX"
+	RankEnvVar       = "RANK"
+	WorldSizeEnvVar  = "WORLD_SIZE"
+	MasterAddrEnvVar = "MASTER_ADDR"
+	MasterPortEnvVar = "MASTER_PORT"
+
+	masterPort = "29500"
+)
+
+// ReplicaSpec describes one named replica group of a distributed job, modeled after the
+// ReplicaSpec pattern used by kubeflow/kubecluster-style training operators.
+type ReplicaSpec struct {
+	Replicas      int32
+	Template      corev1.PodTemplateSpec
+	RestartPolicy corev1.RestartPolicy
+}
+
+// JobSpec is a submission made up of one or more named ReplicaSpecs (e.g. "chief", "worker",
+// "ps"). A JobSpec with a single role and a single replica is a plain Job; anything larger
+// becomes an Indexed Job per role plus a headless Service so roles can address each other.
+type JobSpec struct {
+	Name  string
+	Roles map[string]ReplicaSpec
+}
+
+// TotalReplicas returns the number of pods across all roles.
+func (s JobSpec) TotalReplicas() int32 {
+	var total int32
+	for _, role := range s.Roles {
+		total += role.Replicas
+	}
+	return total
+}
+
+// IsDistributed reports whether the spec needs more than a single plain Job.
+func (s JobSpec) IsDistributed() bool {
+	if len(s.Roles) > 1 {
+		return true
+	}
+	for _, role := range s.Roles {
+		return role.Replicas > 1
+	}
+	return false
+}
+
+func (s JobSpec) sortedRoleNames() []string {
+	names := make([]string, 0, len(s.Roles))
+	for name := range s.Roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// masterRole picks the role pods talk to as rank 0. "chief" and "master" take priority (as in
+// kubeflow's TFJob), falling back to the alphabetically-first role.
+func (s JobSpec) masterRole() string {
+	for _, candidate := range []string{"chief", "master"} {
+		if _, ok := s.Roles[candidate]; ok {
+			return candidate
+		}
+	}
+	names := s.sortedRoleNames()
+	if len(names) == 0 {
+		return ""
+	}
+	return names[0]
+}
+
+// rankOrderedRoleNames returns role names in the order global rank offsets should be assigned:
+// the master role first (so its index-0 pod always lands at global rank 0), then the rest
+// alphabetically.
+func (s JobSpec) rankOrderedRoleNames() []string {
+	master := s.masterRole()
+	names := make([]string, 0, len(s.Roles))
+	names = append(names, master)
+	for _, name := range s.sortedRoleNames() {
+		if name != master {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// BuildDistributedResources builds one Indexed Job per role plus a shared headless Service, so
+// pods can resolve each other at `<job-group>-<role>-<index>.<service>`.
+func BuildDistributedResources(spec JobSpec, namespace string) ([]*batchv1.Job, *corev1.Service) {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      spec.Name,
+			Namespace: namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  map[string]string{JobGroupLabel: spec.Name},
+		},
+	}
+
+	masterRole := spec.masterRole()
+	worldSize := spec.TotalReplicas()
+	masterAddr := fmt.Sprintf("%s-%s-0.%s", spec.Name, masterRole, service.Name)
+
+	var rankOffset int32
+	jobs := make([]*batchv1.Job, 0, len(spec.Roles))
+	for _, role := range spec.rankOrderedRoleNames() {
+		replica := spec.Roles[role]
+		jobs = append(jobs, buildRoleJob(spec.Name, role, replica, namespace, service.Name, rankOffset, worldSize, masterAddr))
+		rankOffset += replica.Replicas
+	}
+
+	return jobs, service
+}
+
+func buildRoleJob(group, role string, replica ReplicaSpec, namespace, serviceName string, rankOffset, worldSize int32, masterAddr string) *batchv1.Job {
+	completions := replica.Replicas
+	completionMode := batchv1.IndexedCompletion
+
+	template := *replica.Template.DeepCopy()
+	if template.Labels == nil {
+		template.Labels = map[string]string{}
+	}
+	template.Labels[JobGroupLabel] = group
+	template.Labels[JobRoleLabel] = role
+	template.Spec.Subdomain = serviceName
+	if template.Spec.RestartPolicy == "" {
+		template.Spec.RestartPolicy = replica.RestartPolicy
+	}
+
+	roleIndexFromAnnotation := &corev1.EnvVarSource{
+		FieldRef: &corev1.ObjectFieldSelector{
+			FieldPath: fmt.Sprintf("metadata.annotations['%s']", batchv1.JobCompletionIndexAnnotation),
+		},
+	}
+	for i := range template.Spec.Containers {
+		container := &template.Spec.Containers[i]
+		container.Env = append(container.Env,
+			corev1.EnvVar{Name: RoleEnvVar, Value: role},
+			corev1.EnvVar{Name: RoleIndexEnvVar, ValueFrom: roleIndexFromAnnotation},
+			corev1.EnvVar{Name: WorldSizeEnvVar, Value: strconv.Itoa(int(worldSize))},
+			corev1.EnvVar{Name: MasterAddrEnvVar, Value: masterAddr},
+			corev1.EnvVar{Name: MasterPortEnvVar, Value: masterPort},
+		)
+		container.Command, container.Args = withGlobalRank(rankOffset, container.Command, container.Args)
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%s", group, role),
+			Namespace:   namespace,
+			Annotations: template.Annotations,
+			Labels: map[string]string{
+				JobGroupLabel: group,
+				JobRoleLabel:  role,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			CompletionMode: &completionMode,
+			Parallelism:    &replica.Replicas,
+			Completions:    &completions,
+			BackoffLimit:   ptrInt32(0),
+			Template:       template,
+		},
+	}
+}
+
+// withGlobalRank wraps a container's command in a shell preamble that exports RANK as this
+// role's static rankOffset plus RoleIndexEnvVar (the pod's per-role completion index), then
+// execs the original command. A field selector alone can't do this addition, so the offset has
+// to be baked into a shell snippet at job-build time instead.
+func withGlobalRank(rankOffset int32, command, args []string) ([]string, []string) {
+	script := fmt.Sprintf(`export %s=$((%d + $%s)); exec "$@"`, RankEnvVar, rankOffset, RoleIndexEnvVar)
+	realCommand := append(append([]string{}, command...), args...)
+	return []string{"sh", "-c", script, "--"}, realCommand
+}
+
+func ptrInt32(v int32) *int32 {
+	return &v
+}
@@ -0,0 +1,92 @@
+package launch
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func replicaSpec(n int32) ReplicaSpec {
+	return ReplicaSpec{
+		Replicas: n,
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "main", Args: []string{"python", "train.py"}}},
+			},
+		},
+	}
+}
+
+func TestJobSpecIsDistributed(t *testing.T) {
+	cases := []struct {
+		name string
+		spec JobSpec
+		want bool
+	}{
+		{"single role, one replica", JobSpec{Roles: map[string]ReplicaSpec{"worker": replicaSpec(1)}}, false},
+		{"single role, many replicas", JobSpec{Roles: map[string]ReplicaSpec{"worker": replicaSpec(4)}}, true},
+		{"multiple roles", JobSpec{Roles: map[string]ReplicaSpec{"chief": replicaSpec(1), "worker": replicaSpec(1)}}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.spec.IsDistributed(); got != c.want {
+				t.Errorf("IsDistributed() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestJobSpecMasterRole(t *testing.T) {
+	cases := []struct {
+		name string
+		spec JobSpec
+		want string
+	}{
+		{"prefers chief", JobSpec{Roles: map[string]ReplicaSpec{"aaa": replicaSpec(1), "chief": replicaSpec(1)}}, "chief"},
+		{"prefers master over alphabetical", JobSpec{Roles: map[string]ReplicaSpec{"aaa": replicaSpec(1), "master": replicaSpec(1)}}, "master"},
+		{"falls back to alphabetically first", JobSpec{Roles: map[string]ReplicaSpec{"worker": replicaSpec(1), "aaa": replicaSpec(1)}}, "aaa"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.spec.masterRole(); got != c.want {
+				t.Errorf("masterRole() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+// TestBuildDistributedResourcesGlobalRank guards against the master role (here "chief", which
+// sorts after "aaa" alphabetically) ending up with a nonzero rank offset: the pod MASTER_ADDR
+// points at must always be global rank 0, and every role's offset must be baked into its
+// container command so RANK is unique across the whole job, not just within a role.
+func TestBuildDistributedResourcesGlobalRank(t *testing.T) {
+	spec := JobSpec{
+		Name: "group",
+		Roles: map[string]ReplicaSpec{
+			"aaa":   replicaSpec(2),
+			"chief": replicaSpec(1),
+		},
+	}
+
+	jobs, _ := BuildDistributedResources(spec, "ns")
+
+	wantOffsets := map[string]int32{"chief": 0, "aaa": 1}
+	for _, job := range jobs {
+		role := job.Labels[JobRoleLabel]
+		wantOffset, ok := wantOffsets[role]
+		if !ok {
+			t.Fatalf("unexpected role %q in built jobs", role)
+		}
+		container := job.Spec.Template.Spec.Containers[0]
+		if len(container.Command) < 3 {
+			t.Fatalf("role %s: expected a rank-exporting shell command, got %v", role, container.Command)
+		}
+		wantCommand, wantArgs := withGlobalRank(wantOffset, nil, []string{"python", "train.py"})
+		if container.Command[2] != wantCommand[2] {
+			t.Errorf("role %s: command = %q, want %q", role, container.Command[2], wantCommand[2])
+		}
+		if got := container.Args; len(got) != len(wantArgs) || got[0] != wantArgs[0] || got[1] != wantArgs[1] {
+			t.Errorf("role %s: args = %v, want %v", role, got, wantArgs)
+		}
+	}
+}
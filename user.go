@@ -0,0 +1,59 @@
+package launch
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+)
+
+// machineUserEnvVar lets CI/automation identify itself as a machine user instead of the
+// Tailscale identity of the host they happen to run on.
+const machineUserEnvVar = "LAUNCH_MACHINE_USER"
+
+// LauncherAnnotations returns the job annotations identifying who is submitting work: a machine
+// user if LAUNCH_MACHINE_USER is set, otherwise the Tailscale identity of the caller, falling
+// back to the local OS user if Tailscale can't be reached.
+func LauncherAnnotations() map[string]string {
+	if machineUser := os.Getenv(machineUserEnvVar); machineUser != "" {
+		return map[string]string{LaunchedByMachineUserAnnotation: machineUser}
+	}
+
+	if tailscaleUser, err := tailscaleLoginName(); err == nil && tailscaleUser != "" {
+		return map[string]string{LaunchedByTailscaleUserAnnotation: tailscaleUser}
+	}
+
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return map[string]string{LaunchedByTailscaleUserAnnotation: u.Username}
+	}
+
+	return nil
+}
+
+type tailscaleStatus struct {
+	Self struct {
+		UserID int64 `json:"UserID"`
+	} `json:"Self"`
+	User map[string]struct {
+		LoginName string `json:"LoginName"`
+	} `json:"User"`
+}
+
+func tailscaleLoginName() (string, error) {
+	output, err := exec.Command("tailscale", "status", "--json").Output()
+	if err != nil {
+		return "", err
+	}
+
+	var status tailscaleStatus
+	if err := json.Unmarshal(output, &status); err != nil {
+		return "", err
+	}
+
+	self, ok := status.User[fmt.Sprint(status.Self.UserID)]
+	if !ok {
+		return "", nil
+	}
+	return self.LoginName, nil
+}